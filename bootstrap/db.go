@@ -0,0 +1,19 @@
+package bootstrap
+
+import (
+	"log"
+
+	"github.com/cs471-buffetpos/buffet-pos-backend/configs"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// NewDB opens the application's database connection and exits the
+// process on failure, since the app cannot serve requests without it.
+func NewDB(cfg *configs.Config) *gorm.DB {
+	db, err := gorm.Open(postgres.Open(cfg.DBDsn), &gorm.Config{})
+	if err != nil {
+		log.Fatalf("failed to connect to database: %v", err)
+	}
+	return db
+}
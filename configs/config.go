@@ -0,0 +1,32 @@
+package configs
+
+import (
+	"os"
+	"time"
+)
+
+// Config holds runtime configuration. DBDsn, JWTSecret and Port come
+// from the environment; the remaining fields are fixed in NewConfig.
+type Config struct {
+	DBDsn               string
+	JWTSecret           string
+	Port                string
+	ForceMFAForManagers bool
+
+	// MaxConcurrentDishes caps how many dishes a table can have in
+	// pending/preparing state at once (typical buffet house rule).
+	MaxConcurrentDishes int
+	// OrderCooldown is the minimum wait between a table's rounds.
+	OrderCooldown time.Duration
+}
+
+func NewConfig() *Config {
+	return &Config{
+		DBDsn:               os.Getenv("DB_DSN"),
+		JWTSecret:           os.Getenv("JWT_SECRET"),
+		Port:                os.Getenv("PORT"),
+		ForceMFAForManagers: true,
+		MaxConcurrentDishes: 6,
+		OrderCooldown:       5 * time.Minute,
+	}
+}
@@ -0,0 +1,8 @@
+package exceptions
+
+import "errors"
+
+var (
+	ErrAPIKeyNotFound = errors.New("api key not found")
+	ErrInvalidAPIKey  = errors.New("invalid api key")
+)
@@ -0,0 +1,8 @@
+package exceptions
+
+import "errors"
+
+var (
+	ErrCategoryNotFound       = errors.New("category not found")
+	ErrDuplicatedCategoryName = errors.New("category name already exists")
+)
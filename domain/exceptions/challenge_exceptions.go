@@ -0,0 +1,12 @@
+package exceptions
+
+import "errors"
+
+var (
+	ErrChallengeNotFound         = errors.New("challenge not found")
+	ErrChallengeExpired          = errors.New("challenge expired")
+	ErrChallengeAlreadyCompleted = errors.New("challenge already completed")
+	ErrFactorNotFound            = errors.New("factor not found for this challenge")
+	ErrInvalidFactorSecret       = errors.New("invalid factor secret")
+	ErrFactorAlreadyEnrolled     = errors.New("factor already enrolled")
+)
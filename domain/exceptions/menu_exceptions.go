@@ -0,0 +1,7 @@
+package exceptions
+
+import "errors"
+
+var (
+	ErrMenuNotFound = errors.New("menu item not found")
+)
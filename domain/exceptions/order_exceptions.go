@@ -0,0 +1,12 @@
+package exceptions
+
+import "errors"
+
+var (
+	ErrOrderNotFound           = errors.New("order not found")
+	ErrInvalidOrderStatus      = errors.New("invalid order status")
+	ErrInvalidStatusTransition = errors.New("invalid order status transition")
+	ErrTooManyConcurrentDishes = errors.New("too many concurrent dishes for this table")
+	ErrOrderCooldownActive     = errors.New("cooldown between rounds is still active")
+	ErrMenuItemNotFound        = errors.New("menu item not found")
+)
@@ -0,0 +1,10 @@
+package exceptions
+
+import "errors"
+
+var (
+	ErrTableNotFound        = errors.New("table not found")
+	ErrDuplicatedTableName  = errors.New("table name already exists")
+	ErrTableAlreadyAssigned = errors.New("table already assigned")
+	ErrInvalidAccessCode    = errors.New("invalid access code")
+)
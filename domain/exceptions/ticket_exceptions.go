@@ -0,0 +1,8 @@
+package exceptions
+
+import "errors"
+
+var (
+	ErrTicketNotFound = errors.New("ticket not found")
+	ErrTicketRevoked  = errors.New("ticket revoked")
+)
@@ -0,0 +1,9 @@
+package exceptions
+
+import "errors"
+
+var (
+	ErrUserNotFound       = errors.New("user not found")
+	ErrDuplicatedEmail    = errors.New("email already registered")
+	ErrInvalidCredentials = errors.New("invalid credentials")
+)
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// APIKey is a long-lived, scope-restricted credential a manager mints
+// for shared hardware (kitchen displays, self-order kiosks) instead of
+// handing out a personal JWT. HashedKey stores a bcrypt hash; the raw
+// key is only ever returned once, at issue time.
+type APIKey struct {
+	ID              uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Name            string    `gorm:"not null"`
+	HashedKey       string    `gorm:"not null"`
+	Scopes          string    `gorm:"not null"`
+	CreatedByUserID uuid.UUID `gorm:"type:uuid;not null"`
+	CreatedAt       time.Time
+	RevokedAt       *time.Time
+}
+
+func (k *APIKey) ScopeList() []string {
+	if k.Scopes == "" {
+		return nil
+	}
+	return strings.Split(k.Scopes, ",")
+}
+
+func JoinScopes(scopes []string) string {
+	return strings.Join(scopes, ",")
+}
@@ -0,0 +1,30 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Challenge tracks one login attempt's progress through the factors
+// required for the target user's role, bound to an IP + User-Agent
+// fingerprint so a stolen challenge ID can't be replayed elsewhere.
+type Challenge struct {
+	ID          uuid.UUID         `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	UserID      uuid.UUID         `gorm:"type:uuid;index;not null"`
+	Fingerprint string            `gorm:"not null"`
+	Factors     []ChallengeFactor `gorm:"foreignKey:ChallengeID"`
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+	CompletedAt *time.Time
+}
+
+// ChallengeFactor is one factor a challenge requires, and whether it
+// has been satisfied yet.
+type ChallengeFactor struct {
+	ID          uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	ChallengeID uuid.UUID  `gorm:"type:uuid;index;not null"`
+	FactorID    uuid.UUID  `gorm:"type:uuid;not null"`
+	Type        FactorType `gorm:"not null"`
+	Satisfied   bool       `gorm:"not null;default:false"`
+}
@@ -0,0 +1,30 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type FactorType string
+
+const (
+	FactorPassword FactorType = "password"
+	FactorTOTP     FactorType = "totp"
+	FactorEmailOTP FactorType = "email_otp"
+)
+
+// Factor is one authentication method enrolled for a user. Secret
+// holds the base32 seed for TOTP, or for email_otp the code that a
+// submitted secret is compared against as-is. Passwords are checked
+// against User.Password and are not stored as a Factor. Nothing
+// generates or mails email_otp codes, and challenges are only ever
+// created with password and TOTP factors.
+type Factor struct {
+	ID        uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	UserID    uuid.UUID  `gorm:"type:uuid;index;not null"`
+	Type      FactorType `gorm:"not null"`
+	Secret    string     `gorm:"not null"`
+	Verified  bool       `gorm:"not null;default:false"`
+	CreatedAt time.Time
+}
@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Menu is a single dish available for ordering, grouped under a
+// Category for browsing on the floor tablets.
+type Menu struct {
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Name       string    `gorm:"not null"`
+	Price      int       `gorm:"not null"`
+	CategoryID uuid.UUID `gorm:"type:uuid;index;not null"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OrderStatus string
+
+const (
+	OrderPending   OrderStatus = "pending"
+	OrderPreparing OrderStatus = "preparing"
+	OrderServed    OrderStatus = "served"
+	OrderCancelled OrderStatus = "cancelled"
+)
+
+// Order is a single round of dishes placed by a seated customer.
+type Order struct {
+	ID        uuid.UUID   `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	TableID   uuid.UUID   `gorm:"type:uuid;index;not null"`
+	Status    OrderStatus `gorm:"not null;default:pending"`
+	Items     []OrderItem `gorm:"foreignKey:OrderID"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// OrderItem is one dish within an order's round.
+type OrderItem struct {
+	ID       uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	OrderID  uuid.UUID `gorm:"type:uuid;index;not null"`
+	MenuID   uuid.UUID `gorm:"type:uuid;not null"`
+	Name     string    `gorm:"not null"`
+	Quantity int       `gorm:"not null"`
+}
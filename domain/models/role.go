@@ -0,0 +1,9 @@
+package models
+
+// Role identifies the privilege level of a staff user.
+type Role string
+
+const (
+	Employee Role = "employee"
+	Manager  Role = "manager"
+)
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TableStatus string
+
+const (
+	TableAvailable TableStatus = "available"
+	TableOccupied  TableStatus = "occupied"
+)
+
+// Table represents a physical dining table that can be assigned an
+// access code for the seated customer to authenticate with.
+type Table struct {
+	ID         uuid.UUID   `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Name       string      `gorm:"uniqueIndex;not null"`
+	Capacity   int         `gorm:"not null"`
+	Status     TableStatus `gorm:"not null;default:available"`
+	AccessCode string      `gorm:"index"`
+	AssignedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
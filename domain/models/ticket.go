@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Ticket is one issued session: a JWT's jti bound to the request that
+// minted it, so a login can be revoked before the token expires.
+type Ticket struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	UserID    uuid.UUID `gorm:"type:uuid;index;not null"`
+	JTI       string    `gorm:"uniqueIndex;not null"`
+	IP        string
+	UserAgent string
+	CreatedAt time.Time
+	RevokedAt *time.Time
+}
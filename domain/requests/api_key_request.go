@@ -0,0 +1,6 @@
+package requests
+
+type CreateAPIKeyRequest struct {
+	Name   string   `json:"name" validate:"required"`
+	Scopes []string `json:"scopes" validate:"required,min=1"`
+}
@@ -0,0 +1,5 @@
+package requests
+
+type AddCategoryRequest struct {
+	Name string `json:"name" validate:"required"`
+}
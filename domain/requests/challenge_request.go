@@ -0,0 +1,14 @@
+package requests
+
+import "github.com/google/uuid"
+
+type CreateChallengeRequest struct {
+	Identifier string `json:"identifier" validate:"required"`
+}
+
+type VerifyChallengeRequest struct {
+	// FactorID is the zero UUID for the password factor, which is
+	// never persisted with a real ID, so it can't carry "required".
+	FactorID uuid.UUID `json:"factor_id"`
+	Secret   string    `json:"secret" validate:"required"`
+}
@@ -0,0 +1,9 @@
+package requests
+
+import "github.com/google/uuid"
+
+type CreateMenuRequest struct {
+	Name       string    `json:"name" validate:"required"`
+	Price      int       `json:"price" validate:"required,gt=0"`
+	CategoryID uuid.UUID `json:"category_id" validate:"required"`
+}
@@ -0,0 +1,16 @@
+package requests
+
+import "github.com/google/uuid"
+
+type PlaceOrderItemRequest struct {
+	MenuID   uuid.UUID `json:"menu_id" validate:"required"`
+	Quantity int       `json:"quantity" validate:"required,gt=0"`
+}
+
+type PlaceOrderRequest struct {
+	Items []PlaceOrderItemRequest `json:"items" validate:"required,min=1,dive"`
+}
+
+type UpdateOrderStatusRequest struct {
+	Status string `json:"status" validate:"required,oneof=pending preparing served cancelled"`
+}
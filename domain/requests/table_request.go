@@ -0,0 +1,18 @@
+package requests
+
+import "github.com/google/uuid"
+
+type AddTableRequest struct {
+	Name     string `json:"name" validate:"required"`
+	Capacity int    `json:"capacity" validate:"required,gt=0"`
+}
+
+type EditTableRequest struct {
+	ID       uuid.UUID `json:"id" validate:"required"`
+	Name     string    `json:"name" validate:"required"`
+	Capacity int       `json:"capacity" validate:"required,gt=0"`
+}
+
+type AssignTableRequest struct {
+	ID uuid.UUID `json:"id" validate:"required"`
+}
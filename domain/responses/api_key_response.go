@@ -0,0 +1,24 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// APIKeyCreated is only ever sent once, right after issuing the key -
+// the server never stores or returns the raw key again.
+type APIKeyCreated struct {
+	ID     uuid.UUID `json:"id"`
+	Name   string    `json:"name"`
+	Key    string    `json:"key"`
+	Scopes []string  `json:"scopes"`
+}
+
+type APIKeyDetail struct {
+	ID        uuid.UUID  `json:"id"`
+	Name      string     `json:"name"`
+	Scopes    []string   `json:"scopes"`
+	CreatedAt time.Time  `json:"created_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+}
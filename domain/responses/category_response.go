@@ -0,0 +1,8 @@
+package responses
+
+import "github.com/google/uuid"
+
+type CategoryDetail struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
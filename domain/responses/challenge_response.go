@@ -0,0 +1,33 @@
+package responses
+
+import (
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/models"
+	"github.com/google/uuid"
+)
+
+type FactorSummary struct {
+	ID   uuid.UUID         `json:"id"`
+	Type models.FactorType `json:"type"`
+}
+
+type ChallengeResponse struct {
+	ChallengeID uuid.UUID       `json:"challenge_id"`
+	Factors     []FactorSummary `json:"factors"`
+	// Enrollment carries the TOTP secret of a pending enrollment (newly
+	// generated, or an earlier unverified one) when the challenge's
+	// second factor is not yet verified, so a manager with nothing
+	// enrolled yet can scan it and complete setup by verifying that
+	// same factor.
+	Enrollment *TOTPEnrollResponse `json:"enrollment,omitempty"`
+}
+
+type VerifyChallengeResponse struct {
+	Completed bool            `json:"completed"`
+	Token     string          `json:"token,omitempty"`
+	Remaining []FactorSummary `json:"remaining,omitempty"`
+}
+
+type TOTPEnrollResponse struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
@@ -0,0 +1,10 @@
+package responses
+
+import "github.com/google/uuid"
+
+type MenuDetail struct {
+	ID         uuid.UUID `json:"id"`
+	Name       string    `json:"name"`
+	Price      int       `json:"price"`
+	CategoryID uuid.UUID `json:"category_id"`
+}
@@ -0,0 +1,22 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/models"
+	"github.com/google/uuid"
+)
+
+type OrderItemDetail struct {
+	MenuID   uuid.UUID `json:"menu_id"`
+	Name     string    `json:"name"`
+	Quantity int       `json:"quantity"`
+}
+
+type OrderDetail struct {
+	ID        uuid.UUID          `json:"id"`
+	TableID   uuid.UUID          `json:"table_id"`
+	Status    models.OrderStatus `json:"status"`
+	Items     []OrderItemDetail  `json:"items"`
+	CreatedAt time.Time          `json:"created_at"`
+}
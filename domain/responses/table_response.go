@@ -0,0 +1,21 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/models"
+	"github.com/google/uuid"
+)
+
+type TableDetail struct {
+	ID         uuid.UUID          `json:"id"`
+	Name       string             `json:"name"`
+	Capacity   int                `json:"capacity"`
+	Status     models.TableStatus `json:"status"`
+	AccessCode string             `json:"access_code,omitempty"`
+	AssignedAt *time.Time         `json:"assigned_at,omitempty"`
+}
+
+type SuccessResponse struct {
+	Message string `json:"message"`
+}
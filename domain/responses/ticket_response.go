@@ -0,0 +1,15 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TicketDetail struct {
+	ID        uuid.UUID `json:"id"`
+	IP        string    `json:"ip"`
+	UserAgent string    `json:"user_agent"`
+	CreatedAt time.Time `json:"created_at"`
+	Current   bool      `json:"current"`
+}
@@ -0,0 +1,13 @@
+package responses
+
+import (
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/models"
+	"github.com/google/uuid"
+)
+
+type UserDetail struct {
+	ID    uuid.UUID   `json:"id"`
+	Name  string      `json:"name"`
+	Email string      `json:"email"`
+	Role  models.Role `json:"role"`
+}
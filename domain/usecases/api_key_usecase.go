@@ -0,0 +1,94 @@
+package usecases
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/exceptions"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/models"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/requests"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/responses"
+	"github.com/cs471-buffetpos/buffet-pos-backend/internal/adapters/gorm"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type APIKeyUseCase interface {
+	IssueAPIKey(ctx context.Context, createdBy uuid.UUID, req *requests.CreateAPIKeyRequest) (*responses.APIKeyCreated, error)
+	RevokeAPIKey(ctx context.Context, id uuid.UUID) error
+	ResolveAPIKey(ctx context.Context, rawKey string) (*models.APIKey, error)
+}
+
+type apiKeyService struct {
+	repo gorm.APIKeyRepository
+}
+
+func NewAPIKeyService(repo gorm.APIKeyRepository) APIKeyUseCase {
+	return &apiKeyService{repo: repo}
+}
+
+func (s *apiKeyService) IssueAPIKey(ctx context.Context, createdBy uuid.UUID, req *requests.CreateAPIKeyRequest) (*responses.APIKeyCreated, error) {
+	rawKey, err := generateAPIKey()
+	if err != nil {
+		return nil, err
+	}
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte(rawKey), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey := &models.APIKey{
+		Name:            req.Name,
+		HashedKey:       string(hashed),
+		Scopes:          models.JoinScopes(req.Scopes),
+		CreatedByUserID: createdBy,
+	}
+	if err := s.repo.Create(ctx, apiKey); err != nil {
+		return nil, err
+	}
+
+	return &responses.APIKeyCreated{
+		ID:     apiKey.ID,
+		Name:   apiKey.Name,
+		Key:    rawKey,
+		Scopes: req.Scopes,
+	}, nil
+}
+
+func (s *apiKeyService) RevokeAPIKey(ctx context.Context, id uuid.UUID) error {
+	apiKey, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if apiKey == nil {
+		return exceptions.ErrAPIKeyNotFound
+	}
+	return s.repo.Revoke(ctx, id)
+}
+
+// ResolveAPIKey matches rawKey against every active key's bcrypt hash.
+// Active API keys are expected to stay few (one per device), so the
+// linear scan is cheap compared to the hash comparisons themselves.
+func (s *apiKeyService) ResolveAPIKey(ctx context.Context, rawKey string) (*models.APIKey, error) {
+	activeKeys, err := s.repo.FindAllActive(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range activeKeys {
+		if bcrypt.CompareHashAndPassword([]byte(key.HashedKey), []byte(rawKey)) == nil {
+			return key, nil
+		}
+	}
+	return nil, exceptions.ErrInvalidAPIKey
+}
+
+func generateAPIKey() (string, error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return "bpos_" + hex.EncodeToString(buf), nil
+}
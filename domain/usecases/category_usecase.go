@@ -0,0 +1,82 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/cs471-buffetpos/buffet-pos-backend/configs"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/exceptions"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/models"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/requests"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/responses"
+	"github.com/cs471-buffetpos/buffet-pos-backend/internal/adapters/gorm"
+	"github.com/cs471-buffetpos/buffet-pos-backend/utils"
+	"github.com/google/uuid"
+)
+
+type CategoryUseCase interface {
+	AddCategory(ctx context.Context, req *requests.AddCategoryRequest) error
+	FindAllCategories(ctx context.Context) ([]*responses.CategoryDetail, error)
+	FindCategoryByID(ctx context.Context, id uuid.UUID) (*responses.CategoryDetail, error)
+	Cache() *utils.ResourceCache
+}
+
+type categoryService struct {
+	repo  gorm.CategoryRepository
+	cfg   *configs.Config
+	cache *utils.ResourceCache
+}
+
+func NewCategoryService(repo gorm.CategoryRepository, cfg *configs.Config) CategoryUseCase {
+	return &categoryService{repo: repo, cfg: cfg, cache: utils.NewResourceCache()}
+}
+
+// Cache exposes the category list's ResourceCache so FindAllCategories
+// can answer conditional GETs without re-serializing the dataset.
+func (s *categoryService) Cache() *utils.ResourceCache {
+	return s.cache
+}
+
+func (s *categoryService) AddCategory(ctx context.Context, req *requests.AddCategoryRequest) error {
+	existing, err := s.repo.FindByName(ctx, req.Name)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return exceptions.ErrDuplicatedCategoryName
+	}
+
+	category := &models.Category{Name: req.Name}
+	if err := s.repo.Create(ctx, category); err != nil {
+		return err
+	}
+	s.cache.Touch()
+	return nil
+}
+
+func (s *categoryService) FindAllCategories(ctx context.Context) ([]*responses.CategoryDetail, error) {
+	categories, err := s.repo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	details := make([]*responses.CategoryDetail, 0, len(categories))
+	for _, category := range categories {
+		details = append(details, toCategoryDetail(category))
+	}
+	return details, nil
+}
+
+func (s *categoryService) FindCategoryByID(ctx context.Context, id uuid.UUID) (*responses.CategoryDetail, error) {
+	category, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if category == nil {
+		return nil, exceptions.ErrCategoryNotFound
+	}
+	return toCategoryDetail(category), nil
+}
+
+func toCategoryDetail(c *models.Category) *responses.CategoryDetail {
+	return &responses.CategoryDetail{ID: c.ID, Name: c.Name}
+}
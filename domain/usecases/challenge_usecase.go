@@ -0,0 +1,304 @@
+package usecases
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/cs471-buffetpos/buffet-pos-backend/configs"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/exceptions"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/models"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/requests"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/responses"
+	"github.com/cs471-buffetpos/buffet-pos-backend/internal/adapters/gorm"
+	"github.com/cs471-buffetpos/buffet-pos-backend/internal/infrastructure/totp"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const challengeTTL = 5 * time.Minute
+
+type ChallengeUseCase interface {
+	CreateChallenge(ctx context.Context, req *requests.CreateChallengeRequest, ip, userAgent string) (*responses.ChallengeResponse, error)
+	VerifyFactor(ctx context.Context, challengeID uuid.UUID, req *requests.VerifyChallengeRequest, ip, userAgent string) (*responses.VerifyChallengeResponse, error)
+	BeginTOTPEnrollment(ctx context.Context, userID uuid.UUID) (*responses.TOTPEnrollResponse, error)
+}
+
+type challengeService struct {
+	userRepo      gorm.UserRepository
+	factorRepo    gorm.FactorRepository
+	challengeRepo gorm.ChallengeRepository
+	ticketRepo    gorm.TicketRepository
+	cfg           *configs.Config
+}
+
+func NewChallengeService(userRepo gorm.UserRepository, factorRepo gorm.FactorRepository, challengeRepo gorm.ChallengeRepository, ticketRepo gorm.TicketRepository, cfg *configs.Config) ChallengeUseCase {
+	return &challengeService{userRepo: userRepo, factorRepo: factorRepo, challengeRepo: challengeRepo, ticketRepo: ticketRepo, cfg: cfg}
+}
+
+func (s *challengeService) CreateChallenge(ctx context.Context, req *requests.CreateChallengeRequest, ip, userAgent string) (*responses.ChallengeResponse, error) {
+	user, err := s.userRepo.FindByEmail(ctx, req.Identifier)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, exceptions.ErrUserNotFound
+	}
+
+	enrolled, err := s.factorRepo.FindByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	challenge := &models.Challenge{
+		UserID:      user.ID,
+		Fingerprint: fingerprint(ip, userAgent),
+		CreatedAt:   time.Now(),
+		ExpiresAt:   time.Now().Add(challengeTTL),
+		Factors: []models.ChallengeFactor{
+			{FactorID: uuid.Nil, Type: models.FactorPassword},
+		},
+	}
+
+	secondFactor, enrollment, err := s.resolveSecondFactor(ctx, user, enrolled)
+	if err != nil {
+		return nil, err
+	}
+	if secondFactor != nil {
+		challenge.Factors = append(challenge.Factors, *secondFactor)
+	}
+
+	if err := s.challengeRepo.Create(ctx, challenge); err != nil {
+		return nil, err
+	}
+
+	resp := toChallengeResponse(challenge)
+	resp.Enrollment = enrollment
+	return resp, nil
+}
+
+// resolveSecondFactor enforces 2FA for managers using their enrolled,
+// verified TOTP factor, while leaving it optional for employees who
+// enrolled one themselves. A manager with nothing verified yet is
+// handed a pending TOTP enrollment as their second factor instead of
+// being locked out - Register hardcodes new users as Employee, so
+// BeginTOTPEnrollment (which needs a JWT already) is never reachable
+// for a manager account created out-of-band. Completing that same
+// factor in VerifyFactor both logs them in and finishes enrollment.
+func (s *challengeService) resolveSecondFactor(ctx context.Context, user *models.User, enrolled []*models.Factor) (*models.ChallengeFactor, *responses.TOTPEnrollResponse, error) {
+	for _, factor := range enrolled {
+		if factor.Type == models.FactorTOTP && factor.Verified {
+			return &models.ChallengeFactor{FactorID: factor.ID, Type: models.FactorTOTP}, nil, nil
+		}
+	}
+
+	if !(user.Role == models.Manager && s.cfg.ForceMFAForManagers) {
+		return nil, nil, nil
+	}
+
+	for _, factor := range enrolled {
+		if factor.Type == models.FactorTOTP && !factor.Verified {
+			return &models.ChallengeFactor{FactorID: factor.ID, Type: models.FactorTOTP},
+				&responses.TOTPEnrollResponse{
+					Secret:          factor.Secret,
+					ProvisioningURI: totp.ProvisioningURI("BuffetPOS", user.Email, factor.Secret),
+				}, nil
+		}
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, nil, err
+	}
+	factor := &models.Factor{UserID: user.ID, Type: models.FactorTOTP, Secret: secret, Verified: false}
+	if err := s.factorRepo.Create(ctx, factor); err != nil {
+		return nil, nil, err
+	}
+
+	return &models.ChallengeFactor{FactorID: factor.ID, Type: models.FactorTOTP},
+		&responses.TOTPEnrollResponse{
+			Secret:          secret,
+			ProvisioningURI: totp.ProvisioningURI("BuffetPOS", user.Email, secret),
+		}, nil
+}
+
+func (s *challengeService) VerifyFactor(ctx context.Context, challengeID uuid.UUID, req *requests.VerifyChallengeRequest, ip, userAgent string) (*responses.VerifyChallengeResponse, error) {
+	challenge, err := s.challengeRepo.FindByID(ctx, challengeID)
+	if err != nil {
+		return nil, err
+	}
+	if challenge == nil {
+		return nil, exceptions.ErrChallengeNotFound
+	}
+	if challenge.CompletedAt != nil {
+		return nil, exceptions.ErrChallengeAlreadyCompleted
+	}
+	if time.Now().After(challenge.ExpiresAt) {
+		return nil, exceptions.ErrChallengeExpired
+	}
+	if challenge.Fingerprint != fingerprint(ip, userAgent) {
+		return nil, exceptions.ErrChallengeNotFound
+	}
+
+	var target *models.ChallengeFactor
+	for i := range challenge.Factors {
+		if challenge.Factors[i].FactorID == req.FactorID || (challenge.Factors[i].Type == models.FactorPassword && req.FactorID == uuid.Nil) {
+			target = &challenge.Factors[i]
+			break
+		}
+	}
+	if target == nil {
+		return nil, exceptions.ErrFactorNotFound
+	}
+
+	user, err := s.userRepo.FindByID(ctx, challenge.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, exceptions.ErrUserNotFound
+	}
+
+	valid, err := s.validateFactorSecret(ctx, user, target, req.Secret)
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		return nil, exceptions.ErrInvalidFactorSecret
+	}
+
+	if err := s.challengeRepo.SatisfyFactor(ctx, target.ID); err != nil {
+		return nil, err
+	}
+	target.Satisfied = true
+
+	if target.Type == models.FactorTOTP {
+		if err := s.factorRepo.Verify(ctx, target.FactorID); err != nil {
+			return nil, err
+		}
+	}
+
+	remaining := make([]responses.FactorSummary, 0)
+	for _, factor := range challenge.Factors {
+		if !factor.Satisfied {
+			remaining = append(remaining, responses.FactorSummary{ID: factor.FactorID, Type: factor.Type})
+		}
+	}
+	if len(remaining) > 0 {
+		return &responses.VerifyChallengeResponse{Completed: false, Remaining: remaining}, nil
+	}
+
+	now := time.Now()
+	challenge.CompletedAt = &now
+	if err := s.challengeRepo.Complete(ctx, challenge); err != nil {
+		return nil, err
+	}
+
+	token, err := s.mintToken(ctx, user, ip, userAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	return &responses.VerifyChallengeResponse{Completed: true, Token: token}, nil
+}
+
+func (s *challengeService) validateFactorSecret(ctx context.Context, user *models.User, factor *models.ChallengeFactor, secret string) (bool, error) {
+	switch factor.Type {
+	case models.FactorPassword:
+		return bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(secret)) == nil, nil
+	case models.FactorTOTP:
+		enrolled, err := s.factorRepo.FindByID(ctx, factor.FactorID)
+		if err != nil || enrolled == nil {
+			return false, err
+		}
+		return totp.Validate(enrolled.Secret, secret, time.Now()), nil
+	case models.FactorEmailOTP:
+		enrolled, err := s.factorRepo.FindByID(ctx, factor.FactorID)
+		if err != nil || enrolled == nil {
+			return false, err
+		}
+		return enrolled.Secret == secret, nil
+	default:
+		return false, exceptions.ErrFactorNotFound
+	}
+}
+
+func (s *challengeService) BeginTOTPEnrollment(ctx context.Context, userID uuid.UUID) (*responses.TOTPEnrollResponse, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, exceptions.ErrUserNotFound
+	}
+
+	existing, err := s.factorRepo.FindByUserIDAndType(ctx, userID, models.FactorTOTP)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil && existing.Verified {
+		return nil, exceptions.ErrFactorAlreadyEnrolled
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	factor := &models.Factor{
+		UserID:   userID,
+		Type:     models.FactorTOTP,
+		Secret:   secret,
+		Verified: true,
+	}
+	if err := s.factorRepo.Create(ctx, factor); err != nil {
+		return nil, err
+	}
+
+	return &responses.TOTPEnrollResponse{
+		Secret:          secret,
+		ProvisioningURI: totp.ProvisioningURI("BuffetPOS", user.Email, secret),
+	}, nil
+}
+
+func toChallengeResponse(challenge *models.Challenge) *responses.ChallengeResponse {
+	factors := make([]responses.FactorSummary, 0, len(challenge.Factors))
+	for _, factor := range challenge.Factors {
+		factors = append(factors, responses.FactorSummary{ID: factor.FactorID, Type: factor.Type})
+	}
+	return &responses.ChallengeResponse{
+		ChallengeID: challenge.ID,
+		Factors:     factors,
+	}
+}
+
+func fingerprint(ip, userAgent string) string {
+	sum := sha256.Sum256([]byte(ip + "|" + userAgent))
+	return hex.EncodeToString(sum[:])
+}
+
+// mintToken opens a ticket for this login and embeds the ticket's JTI
+// as the JWT's jti claim, so AuthMiddleware can revoke the session
+// before it expires naturally.
+func (s *challengeService) mintToken(ctx context.Context, user *models.User, ip, userAgent string) (string, error) {
+	ticket := &models.Ticket{
+		UserID:    user.ID,
+		JTI:       uuid.NewString(),
+		IP:        ip,
+		UserAgent: userAgent,
+	}
+	if err := s.ticketRepo.Create(ctx, ticket); err != nil {
+		return "", err
+	}
+
+	claims := jwt.MapClaims{
+		"user_id": user.ID.String(),
+		"role":    user.Role,
+		"jti":     ticket.JTI,
+		"exp":     time.Now().Add(24 * time.Hour).Unix(),
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString([]byte(s.cfg.JWTSecret))
+}
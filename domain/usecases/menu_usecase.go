@@ -0,0 +1,92 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/cs471-buffetpos/buffet-pos-backend/configs"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/exceptions"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/models"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/requests"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/responses"
+	"github.com/cs471-buffetpos/buffet-pos-backend/internal/adapters/gorm"
+	"github.com/cs471-buffetpos/buffet-pos-backend/utils"
+	"github.com/google/uuid"
+)
+
+type MenuUseCase interface {
+	Create(ctx context.Context, req *requests.CreateMenuRequest) error
+	FindAll(ctx context.Context) ([]*responses.MenuDetail, error)
+	FindByID(ctx context.Context, id uuid.UUID) (*responses.MenuDetail, error)
+	Cache() *utils.ResourceCache
+}
+
+type menuService struct {
+	repo         gorm.MenuRepository
+	categoryRepo gorm.CategoryRepository
+	cfg          *configs.Config
+	cache        *utils.ResourceCache
+}
+
+func NewMenuService(repo gorm.MenuRepository, categoryRepo gorm.CategoryRepository, cfg *configs.Config) MenuUseCase {
+	return &menuService{repo: repo, categoryRepo: categoryRepo, cfg: cfg, cache: utils.NewResourceCache()}
+}
+
+// Cache exposes the menu list's ResourceCache so FindAll can answer
+// conditional GETs without re-serializing the dataset.
+func (s *menuService) Cache() *utils.ResourceCache {
+	return s.cache
+}
+
+func (s *menuService) Create(ctx context.Context, req *requests.CreateMenuRequest) error {
+	category, err := s.categoryRepo.FindByID(ctx, req.CategoryID)
+	if err != nil {
+		return err
+	}
+	if category == nil {
+		return exceptions.ErrCategoryNotFound
+	}
+
+	menu := &models.Menu{
+		Name:       req.Name,
+		Price:      req.Price,
+		CategoryID: req.CategoryID,
+	}
+	if err := s.repo.Create(ctx, menu); err != nil {
+		return err
+	}
+	s.cache.Touch()
+	return nil
+}
+
+func (s *menuService) FindAll(ctx context.Context) ([]*responses.MenuDetail, error) {
+	menus, err := s.repo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	details := make([]*responses.MenuDetail, 0, len(menus))
+	for _, menu := range menus {
+		details = append(details, toMenuDetail(menu))
+	}
+	return details, nil
+}
+
+func (s *menuService) FindByID(ctx context.Context, id uuid.UUID) (*responses.MenuDetail, error) {
+	menu, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if menu == nil {
+		return nil, exceptions.ErrMenuNotFound
+	}
+	return toMenuDetail(menu), nil
+}
+
+func toMenuDetail(m *models.Menu) *responses.MenuDetail {
+	return &responses.MenuDetail{
+		ID:         m.ID,
+		Name:       m.Name,
+		Price:      m.Price,
+		CategoryID: m.CategoryID,
+	}
+}
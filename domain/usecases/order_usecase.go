@@ -0,0 +1,163 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/cs471-buffetpos/buffet-pos-backend/configs"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/exceptions"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/models"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/requests"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/responses"
+	"github.com/cs471-buffetpos/buffet-pos-backend/internal/adapters/gorm"
+	"github.com/cs471-buffetpos/buffet-pos-backend/internal/infrastructure/ws"
+	"github.com/google/uuid"
+)
+
+type OrderUseCase interface {
+	PlaceOrder(ctx context.Context, tableID uuid.UUID, req *requests.PlaceOrderRequest) (*responses.OrderDetail, error)
+	FindAllOrders(ctx context.Context) ([]*responses.OrderDetail, error)
+	UpdateOrderStatus(ctx context.Context, id uuid.UUID, req *requests.UpdateOrderStatusRequest) (*responses.OrderDetail, error)
+}
+
+// allowedTransitions enumerates the buffet order lifecycle: pending ->
+// preparing -> served, with cancellation possible until it's served.
+var allowedTransitions = map[models.OrderStatus][]models.OrderStatus{
+	models.OrderPending:   {models.OrderPreparing, models.OrderCancelled},
+	models.OrderPreparing: {models.OrderServed, models.OrderCancelled},
+	models.OrderServed:    {},
+	models.OrderCancelled: {},
+}
+
+type orderService struct {
+	repo     gorm.OrderRepository
+	menuRepo gorm.MenuRepository
+	cfg      *configs.Config
+	hub      *ws.Hub
+}
+
+func NewOrderService(repo gorm.OrderRepository, menuRepo gorm.MenuRepository, cfg *configs.Config, hub *ws.Hub) OrderUseCase {
+	return &orderService{repo: repo, menuRepo: menuRepo, cfg: cfg, hub: hub}
+}
+
+func (s *orderService) PlaceOrder(ctx context.Context, tableID uuid.UUID, req *requests.PlaceOrderRequest) (*responses.OrderDetail, error) {
+	last, err := s.repo.FindLastByTableID(ctx, tableID)
+	if err != nil {
+		return nil, err
+	}
+	if last != nil && time.Since(last.CreatedAt) < s.cfg.OrderCooldown {
+		return nil, exceptions.ErrOrderCooldownActive
+	}
+
+	active, err := s.repo.FindActiveByTableID(ctx, tableID)
+	if err != nil {
+		return nil, err
+	}
+
+	concurrentDishes := 0
+	for _, order := range active {
+		for _, item := range order.Items {
+			concurrentDishes += item.Quantity
+		}
+	}
+	for _, item := range req.Items {
+		concurrentDishes += item.Quantity
+	}
+	if concurrentDishes > s.cfg.MaxConcurrentDishes {
+		return nil, exceptions.ErrTooManyConcurrentDishes
+	}
+
+	items := make([]models.OrderItem, 0, len(req.Items))
+	for _, item := range req.Items {
+		dish, err := s.menuRepo.FindByID(ctx, item.MenuID)
+		if err != nil {
+			return nil, err
+		}
+		if dish == nil {
+			return nil, exceptions.ErrMenuItemNotFound
+		}
+
+		items = append(items, models.OrderItem{
+			MenuID:   item.MenuID,
+			Name:     dish.Name,
+			Quantity: item.Quantity,
+		})
+	}
+
+	order := &models.Order{
+		TableID: tableID,
+		Status:  models.OrderPending,
+		Items:   items,
+	}
+	if err := s.repo.Create(ctx, order); err != nil {
+		return nil, err
+	}
+
+	detail := toOrderDetail(order)
+	s.hub.Broadcast(ws.Event{Type: "order.created", Order: detail})
+	return detail, nil
+}
+
+func (s *orderService) FindAllOrders(ctx context.Context) ([]*responses.OrderDetail, error) {
+	orders, err := s.repo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	details := make([]*responses.OrderDetail, 0, len(orders))
+	for _, o := range orders {
+		details = append(details, toOrderDetail(o))
+	}
+	return details, nil
+}
+
+func (s *orderService) UpdateOrderStatus(ctx context.Context, id uuid.UUID, req *requests.UpdateOrderStatusRequest) (*responses.OrderDetail, error) {
+	order, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if order == nil {
+		return nil, exceptions.ErrOrderNotFound
+	}
+
+	next := models.OrderStatus(req.Status)
+	if !isAllowedTransition(order.Status, next) {
+		return nil, exceptions.ErrInvalidStatusTransition
+	}
+
+	order.Status = next
+	if err := s.repo.Update(ctx, order); err != nil {
+		return nil, err
+	}
+
+	detail := toOrderDetail(order)
+	s.hub.Broadcast(ws.Event{Type: "order.status_changed", Order: detail})
+	return detail, nil
+}
+
+func isAllowedTransition(from, to models.OrderStatus) bool {
+	for _, candidate := range allowedTransitions[from] {
+		if candidate == to {
+			return true
+		}
+	}
+	return false
+}
+
+func toOrderDetail(o *models.Order) *responses.OrderDetail {
+	items := make([]responses.OrderItemDetail, 0, len(o.Items))
+	for _, item := range o.Items {
+		items = append(items, responses.OrderItemDetail{
+			MenuID:   item.MenuID,
+			Name:     item.Name,
+			Quantity: item.Quantity,
+		})
+	}
+	return &responses.OrderDetail{
+		ID:        o.ID,
+		TableID:   o.TableID,
+		Status:    o.Status,
+		Items:     items,
+		CreatedAt: o.CreatedAt,
+	}
+}
@@ -0,0 +1,181 @@
+package usecases
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/cs471-buffetpos/buffet-pos-backend/configs"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/exceptions"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/models"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/requests"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/responses"
+	"github.com/cs471-buffetpos/buffet-pos-backend/internal/adapters/gorm"
+	"github.com/cs471-buffetpos/buffet-pos-backend/utils"
+	"github.com/google/uuid"
+)
+
+type TableUseCase interface {
+	AddTable(ctx context.Context, req *requests.AddTableRequest) error
+	FindAllTables(ctx context.Context) ([]*responses.TableDetail, error)
+	FindTableByID(ctx context.Context, id uuid.UUID) (*responses.TableDetail, error)
+	EditTable(ctx context.Context, req *requests.EditTableRequest) error
+	DeleteTable(ctx context.Context, id uuid.UUID) error
+	AssignTable(ctx context.Context, req *requests.AssignTableRequest) (*responses.TableDetail, error)
+	FindTableByAccessCode(ctx context.Context, accessCode string) (*responses.TableDetail, error)
+	Cache() *utils.ResourceCache
+}
+
+type tableService struct {
+	repo  gorm.TableRepository
+	cfg   *configs.Config
+	cache *utils.ResourceCache
+}
+
+func NewTableService(repo gorm.TableRepository, cfg *configs.Config) TableUseCase {
+	return &tableService{repo: repo, cfg: cfg, cache: utils.NewResourceCache()}
+}
+
+// Cache exposes the table list's ResourceCache so FindAllTables can
+// answer conditional GETs without re-serializing the dataset.
+func (s *tableService) Cache() *utils.ResourceCache {
+	return s.cache
+}
+
+func (s *tableService) AddTable(ctx context.Context, req *requests.AddTableRequest) error {
+	existing, err := s.repo.FindByName(ctx, req.Name)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return exceptions.ErrDuplicatedTableName
+	}
+
+	table := &models.Table{
+		Name:     req.Name,
+		Capacity: req.Capacity,
+		Status:   models.TableAvailable,
+	}
+	if err := s.repo.Create(ctx, table); err != nil {
+		return err
+	}
+	s.cache.Touch()
+	return nil
+}
+
+func (s *tableService) FindAllTables(ctx context.Context) ([]*responses.TableDetail, error) {
+	tables, err := s.repo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	details := make([]*responses.TableDetail, 0, len(tables))
+	for _, t := range tables {
+		details = append(details, toTableDetail(t))
+	}
+	return details, nil
+}
+
+func (s *tableService) FindTableByID(ctx context.Context, id uuid.UUID) (*responses.TableDetail, error) {
+	table, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if table == nil {
+		return nil, exceptions.ErrTableNotFound
+	}
+	return toTableDetail(table), nil
+}
+
+func (s *tableService) EditTable(ctx context.Context, req *requests.EditTableRequest) error {
+	table, err := s.repo.FindByID(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+	if table == nil {
+		return exceptions.ErrTableNotFound
+	}
+
+	table.Name = req.Name
+	table.Capacity = req.Capacity
+	if err := s.repo.Update(ctx, table); err != nil {
+		return err
+	}
+	s.cache.Touch()
+	return nil
+}
+
+func (s *tableService) DeleteTable(ctx context.Context, id uuid.UUID) error {
+	table, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if table == nil {
+		return exceptions.ErrTableNotFound
+	}
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.cache.Touch()
+	return nil
+}
+
+func (s *tableService) AssignTable(ctx context.Context, req *requests.AssignTableRequest) (*responses.TableDetail, error) {
+	table, err := s.repo.FindByID(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if table == nil {
+		return nil, exceptions.ErrTableNotFound
+	}
+	if table.Status == models.TableOccupied {
+		return nil, exceptions.ErrTableAlreadyAssigned
+	}
+
+	code, err := generateAccessCode()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	table.Status = models.TableOccupied
+	table.AccessCode = code
+	table.AssignedAt = &now
+	if err := s.repo.Update(ctx, table); err != nil {
+		return nil, err
+	}
+	s.cache.Touch()
+
+	return toTableDetail(table), nil
+}
+
+func (s *tableService) FindTableByAccessCode(ctx context.Context, accessCode string) (*responses.TableDetail, error) {
+	table, err := s.repo.FindByAccessCode(ctx, accessCode)
+	if err != nil {
+		return nil, err
+	}
+	if table == nil || table.Status != models.TableOccupied {
+		return nil, exceptions.ErrInvalidAccessCode
+	}
+	return toTableDetail(table), nil
+}
+
+func generateAccessCode() (string, error) {
+	buf := make([]byte, 4)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+func toTableDetail(t *models.Table) *responses.TableDetail {
+	return &responses.TableDetail{
+		ID:         t.ID,
+		Name:       t.Name,
+		Capacity:   t.Capacity,
+		Status:     t.Status,
+		AccessCode: t.AccessCode,
+		AssignedAt: t.AssignedAt,
+	}
+}
@@ -0,0 +1,63 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/exceptions"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/responses"
+	"github.com/cs471-buffetpos/buffet-pos-backend/internal/adapters/gorm"
+	"github.com/cs471-buffetpos/buffet-pos-backend/utils"
+	"github.com/google/uuid"
+)
+
+type TicketUseCase interface {
+	ListTickets(ctx context.Context, userID uuid.UUID, currentJTI string) ([]*responses.TicketDetail, error)
+	RevokeTicket(ctx context.Context, userID, ticketID uuid.UUID) error
+}
+
+type ticketService struct {
+	repo  gorm.TicketRepository
+	cache *utils.TicketCache
+}
+
+func NewTicketService(repo gorm.TicketRepository, cache *utils.TicketCache) TicketUseCase {
+	return &ticketService{repo: repo, cache: cache}
+}
+
+func (s *ticketService) ListTickets(ctx context.Context, userID uuid.UUID, currentJTI string) ([]*responses.TicketDetail, error) {
+	tickets, err := s.repo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	details := make([]*responses.TicketDetail, 0, len(tickets))
+	for _, t := range tickets {
+		details = append(details, &responses.TicketDetail{
+			ID:        t.ID,
+			IP:        t.IP,
+			UserAgent: t.UserAgent,
+			CreatedAt: t.CreatedAt,
+			Current:   t.JTI == currentJTI,
+		})
+	}
+	return details, nil
+}
+
+func (s *ticketService) RevokeTicket(ctx context.Context, userID, ticketID uuid.UUID) error {
+	ticket, err := s.repo.FindByID(ctx, ticketID)
+	if err != nil {
+		return err
+	}
+	if ticket == nil || ticket.UserID != userID {
+		return exceptions.ErrTicketNotFound
+	}
+	if ticket.RevokedAt != nil {
+		return nil
+	}
+
+	if err := s.repo.Revoke(ctx, ticketID); err != nil {
+		return err
+	}
+	s.cache.Invalidate(ticket.JTI)
+	return nil
+}
@@ -0,0 +1,48 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/cs471-buffetpos/buffet-pos-backend/configs"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/exceptions"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/models"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/requests"
+	"github.com/cs471-buffetpos/buffet-pos-backend/internal/adapters/gorm"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type UserUseCase interface {
+	Register(ctx context.Context, req *requests.RegisterRequest) error
+}
+
+type userService struct {
+	repo gorm.UserRepository
+	cfg  *configs.Config
+}
+
+func NewUserService(repo gorm.UserRepository, cfg *configs.Config) UserUseCase {
+	return &userService{repo: repo, cfg: cfg}
+}
+
+func (s *userService) Register(ctx context.Context, req *requests.RegisterRequest) error {
+	existing, err := s.repo.FindByEmail(ctx, req.Email)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return exceptions.ErrDuplicatedEmail
+	}
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	user := &models.User{
+		Name:     req.Name,
+		Email:    req.Email,
+		Password: string(hashed),
+		Role:     models.Employee,
+	}
+	return s.repo.Create(ctx, user)
+}
@@ -0,0 +1,56 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, apiKey *models.APIKey) error
+	FindByID(ctx context.Context, id uuid.UUID) (*models.APIKey, error)
+	FindAllActive(ctx context.Context) ([]*models.APIKey, error)
+	Revoke(ctx context.Context, id uuid.UUID) error
+}
+
+type apiKeyGormRepository struct {
+	db *gorm.DB
+}
+
+func NewAPIKeyGormRepository(db *gorm.DB) APIKeyRepository {
+	return &apiKeyGormRepository{db: db}
+}
+
+func (r *apiKeyGormRepository) Create(ctx context.Context, apiKey *models.APIKey) error {
+	return r.db.WithContext(ctx).Create(apiKey).Error
+}
+
+func (r *apiKeyGormRepository) FindByID(ctx context.Context, id uuid.UUID) (*models.APIKey, error) {
+	var apiKey models.APIKey
+	if err := r.db.WithContext(ctx).First(&apiKey, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &apiKey, nil
+}
+
+func (r *apiKeyGormRepository) FindAllActive(ctx context.Context) ([]*models.APIKey, error) {
+	var apiKeys []*models.APIKey
+	if err := r.db.WithContext(ctx).Where("revoked_at IS NULL").Find(&apiKeys).Error; err != nil {
+		return nil, err
+	}
+	return apiKeys, nil
+}
+
+func (r *apiKeyGormRepository) Revoke(ctx context.Context, id uuid.UUID) error {
+	now := time.Now()
+	return r.db.WithContext(ctx).Model(&models.APIKey{}).
+		Where("id = ?", id).
+		Update("revoked_at", now).Error
+}
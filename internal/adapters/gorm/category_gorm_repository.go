@@ -0,0 +1,59 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type CategoryRepository interface {
+	Create(ctx context.Context, category *models.Category) error
+	FindAll(ctx context.Context) ([]*models.Category, error)
+	FindByID(ctx context.Context, id uuid.UUID) (*models.Category, error)
+	FindByName(ctx context.Context, name string) (*models.Category, error)
+}
+
+type categoryGormRepository struct {
+	db *gorm.DB
+}
+
+func NewCategoryGormRepository(db *gorm.DB) CategoryRepository {
+	return &categoryGormRepository{db: db}
+}
+
+func (r *categoryGormRepository) Create(ctx context.Context, category *models.Category) error {
+	return r.db.WithContext(ctx).Create(category).Error
+}
+
+func (r *categoryGormRepository) FindAll(ctx context.Context) ([]*models.Category, error) {
+	var categories []*models.Category
+	if err := r.db.WithContext(ctx).Find(&categories).Error; err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
+
+func (r *categoryGormRepository) FindByID(ctx context.Context, id uuid.UUID) (*models.Category, error) {
+	var category models.Category
+	if err := r.db.WithContext(ctx).First(&category, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &category, nil
+}
+
+func (r *categoryGormRepository) FindByName(ctx context.Context, name string) (*models.Category, error) {
+	var category models.Category
+	if err := r.db.WithContext(ctx).First(&category, "name = ?", name).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &category, nil
+}
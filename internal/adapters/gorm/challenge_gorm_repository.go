@@ -0,0 +1,50 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ChallengeRepository interface {
+	Create(ctx context.Context, challenge *models.Challenge) error
+	FindByID(ctx context.Context, id uuid.UUID) (*models.Challenge, error)
+	SatisfyFactor(ctx context.Context, challengeFactorID uuid.UUID) error
+	Complete(ctx context.Context, challenge *models.Challenge) error
+}
+
+type challengeGormRepository struct {
+	db *gorm.DB
+}
+
+func NewChallengeGormRepository(db *gorm.DB) ChallengeRepository {
+	return &challengeGormRepository{db: db}
+}
+
+func (r *challengeGormRepository) Create(ctx context.Context, challenge *models.Challenge) error {
+	return r.db.WithContext(ctx).Create(challenge).Error
+}
+
+func (r *challengeGormRepository) FindByID(ctx context.Context, id uuid.UUID) (*models.Challenge, error) {
+	var challenge models.Challenge
+	if err := r.db.WithContext(ctx).Preload("Factors").First(&challenge, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &challenge, nil
+}
+
+func (r *challengeGormRepository) SatisfyFactor(ctx context.Context, challengeFactorID uuid.UUID) error {
+	return r.db.WithContext(ctx).Model(&models.ChallengeFactor{}).
+		Where("id = ?", challengeFactorID).
+		Update("satisfied", true).Error
+}
+
+func (r *challengeGormRepository) Complete(ctx context.Context, challenge *models.Challenge) error {
+	return r.db.WithContext(ctx).Save(challenge).Error
+}
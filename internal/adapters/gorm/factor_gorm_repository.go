@@ -0,0 +1,67 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type FactorRepository interface {
+	Create(ctx context.Context, factor *models.Factor) error
+	FindByID(ctx context.Context, id uuid.UUID) (*models.Factor, error)
+	FindByUserID(ctx context.Context, userID uuid.UUID) ([]*models.Factor, error)
+	FindByUserIDAndType(ctx context.Context, userID uuid.UUID, factorType models.FactorType) (*models.Factor, error)
+	Verify(ctx context.Context, id uuid.UUID) error
+}
+
+type factorGormRepository struct {
+	db *gorm.DB
+}
+
+func NewFactorGormRepository(db *gorm.DB) FactorRepository {
+	return &factorGormRepository{db: db}
+}
+
+func (r *factorGormRepository) Create(ctx context.Context, factor *models.Factor) error {
+	return r.db.WithContext(ctx).Create(factor).Error
+}
+
+func (r *factorGormRepository) FindByID(ctx context.Context, id uuid.UUID) (*models.Factor, error) {
+	var factor models.Factor
+	if err := r.db.WithContext(ctx).First(&factor, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &factor, nil
+}
+
+func (r *factorGormRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*models.Factor, error) {
+	var factors []*models.Factor
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Find(&factors).Error; err != nil {
+		return nil, err
+	}
+	return factors, nil
+}
+
+func (r *factorGormRepository) FindByUserIDAndType(ctx context.Context, userID uuid.UUID, factorType models.FactorType) (*models.Factor, error) {
+	var factor models.Factor
+	err := r.db.WithContext(ctx).First(&factor, "user_id = ? AND type = ?", userID, factorType).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &factor, nil
+}
+
+func (r *factorGormRepository) Verify(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Model(&models.Factor{}).
+		Where("id = ?", id).
+		Update("verified", true).Error
+}
@@ -0,0 +1,47 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type MenuRepository interface {
+	Create(ctx context.Context, menu *models.Menu) error
+	FindAll(ctx context.Context) ([]*models.Menu, error)
+	FindByID(ctx context.Context, id uuid.UUID) (*models.Menu, error)
+}
+
+type menuGormRepository struct {
+	db *gorm.DB
+}
+
+func NewMenuGormRepository(db *gorm.DB) MenuRepository {
+	return &menuGormRepository{db: db}
+}
+
+func (r *menuGormRepository) Create(ctx context.Context, menu *models.Menu) error {
+	return r.db.WithContext(ctx).Create(menu).Error
+}
+
+func (r *menuGormRepository) FindAll(ctx context.Context) ([]*models.Menu, error) {
+	var menus []*models.Menu
+	if err := r.db.WithContext(ctx).Find(&menus).Error; err != nil {
+		return nil, err
+	}
+	return menus, nil
+}
+
+func (r *menuGormRepository) FindByID(ctx context.Context, id uuid.UUID) (*models.Menu, error) {
+	var menu models.Menu
+	if err := r.db.WithContext(ctx).First(&menu, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &menu, nil
+}
@@ -0,0 +1,77 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type OrderRepository interface {
+	Create(ctx context.Context, order *models.Order) error
+	FindAll(ctx context.Context) ([]*models.Order, error)
+	FindByID(ctx context.Context, id uuid.UUID) (*models.Order, error)
+	FindActiveByTableID(ctx context.Context, tableID uuid.UUID) ([]*models.Order, error)
+	FindLastByTableID(ctx context.Context, tableID uuid.UUID) (*models.Order, error)
+	Update(ctx context.Context, order *models.Order) error
+}
+
+type orderGormRepository struct {
+	db *gorm.DB
+}
+
+func NewOrderGormRepository(db *gorm.DB) OrderRepository {
+	return &orderGormRepository{db: db}
+}
+
+func (r *orderGormRepository) Create(ctx context.Context, order *models.Order) error {
+	return r.db.WithContext(ctx).Create(order).Error
+}
+
+func (r *orderGormRepository) FindAll(ctx context.Context) ([]*models.Order, error) {
+	var orders []*models.Order
+	if err := r.db.WithContext(ctx).Preload("Items").Order("created_at desc").Find(&orders).Error; err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+func (r *orderGormRepository) FindByID(ctx context.Context, id uuid.UUID) (*models.Order, error) {
+	var order models.Order
+	if err := r.db.WithContext(ctx).Preload("Items").First(&order, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &order, nil
+}
+
+func (r *orderGormRepository) FindActiveByTableID(ctx context.Context, tableID uuid.UUID) ([]*models.Order, error) {
+	var orders []*models.Order
+	err := r.db.WithContext(ctx).Preload("Items").
+		Where("table_id = ? AND status IN ?", tableID, []models.OrderStatus{models.OrderPending, models.OrderPreparing}).
+		Find(&orders).Error
+	if err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+func (r *orderGormRepository) FindLastByTableID(ctx context.Context, tableID uuid.UUID) (*models.Order, error) {
+	var order models.Order
+	err := r.db.WithContext(ctx).Where("table_id = ?", tableID).Order("created_at desc").First(&order).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &order, nil
+}
+
+func (r *orderGormRepository) Update(ctx context.Context, order *models.Order) error {
+	return r.db.WithContext(ctx).Save(order).Error
+}
@@ -0,0 +1,81 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type TableRepository interface {
+	Create(ctx context.Context, table *models.Table) error
+	FindAll(ctx context.Context) ([]*models.Table, error)
+	FindByID(ctx context.Context, id uuid.UUID) (*models.Table, error)
+	FindByName(ctx context.Context, name string) (*models.Table, error)
+	FindByAccessCode(ctx context.Context, accessCode string) (*models.Table, error)
+	Update(ctx context.Context, table *models.Table) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type tableGormRepository struct {
+	db *gorm.DB
+}
+
+func NewTableGormRepository(db *gorm.DB) TableRepository {
+	return &tableGormRepository{db: db}
+}
+
+func (r *tableGormRepository) Create(ctx context.Context, table *models.Table) error {
+	return r.db.WithContext(ctx).Create(table).Error
+}
+
+func (r *tableGormRepository) FindAll(ctx context.Context) ([]*models.Table, error) {
+	var tables []*models.Table
+	if err := r.db.WithContext(ctx).Find(&tables).Error; err != nil {
+		return nil, err
+	}
+	return tables, nil
+}
+
+func (r *tableGormRepository) FindByID(ctx context.Context, id uuid.UUID) (*models.Table, error) {
+	var table models.Table
+	if err := r.db.WithContext(ctx).First(&table, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &table, nil
+}
+
+func (r *tableGormRepository) FindByName(ctx context.Context, name string) (*models.Table, error) {
+	var table models.Table
+	if err := r.db.WithContext(ctx).First(&table, "name = ?", name).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &table, nil
+}
+
+func (r *tableGormRepository) FindByAccessCode(ctx context.Context, accessCode string) (*models.Table, error) {
+	var table models.Table
+	if err := r.db.WithContext(ctx).First(&table, "access_code = ?", accessCode).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &table, nil
+}
+
+func (r *tableGormRepository) Update(ctx context.Context, table *models.Table) error {
+	return r.db.WithContext(ctx).Save(table).Error
+}
+
+func (r *tableGormRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&models.Table{}, "id = ?", id).Error
+}
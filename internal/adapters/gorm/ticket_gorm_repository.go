@@ -0,0 +1,70 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type TicketRepository interface {
+	Create(ctx context.Context, ticket *models.Ticket) error
+	FindByJTI(ctx context.Context, jti string) (*models.Ticket, error)
+	FindByID(ctx context.Context, id uuid.UUID) (*models.Ticket, error)
+	FindByUserID(ctx context.Context, userID uuid.UUID) ([]*models.Ticket, error)
+	Revoke(ctx context.Context, id uuid.UUID) error
+}
+
+type ticketGormRepository struct {
+	db *gorm.DB
+}
+
+func NewTicketGormRepository(db *gorm.DB) TicketRepository {
+	return &ticketGormRepository{db: db}
+}
+
+func (r *ticketGormRepository) Create(ctx context.Context, ticket *models.Ticket) error {
+	return r.db.WithContext(ctx).Create(ticket).Error
+}
+
+func (r *ticketGormRepository) FindByJTI(ctx context.Context, jti string) (*models.Ticket, error) {
+	var ticket models.Ticket
+	if err := r.db.WithContext(ctx).First(&ticket, "jti = ?", jti).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &ticket, nil
+}
+
+func (r *ticketGormRepository) FindByID(ctx context.Context, id uuid.UUID) (*models.Ticket, error) {
+	var ticket models.Ticket
+	if err := r.db.WithContext(ctx).First(&ticket, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &ticket, nil
+}
+
+func (r *ticketGormRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*models.Ticket, error) {
+	var tickets []*models.Ticket
+	err := r.db.WithContext(ctx).Where("user_id = ? AND revoked_at IS NULL", userID).
+		Order("created_at desc").Find(&tickets).Error
+	if err != nil {
+		return nil, err
+	}
+	return tickets, nil
+}
+
+func (r *ticketGormRepository) Revoke(ctx context.Context, id uuid.UUID) error {
+	now := time.Now()
+	return r.db.WithContext(ctx).Model(&models.Ticket{}).
+		Where("id = ?", id).
+		Update("revoked_at", now).Error
+}
@@ -4,14 +4,41 @@ import (
 	"strings"
 
 	"github.com/cs471-buffetpos/buffet-pos-backend/configs"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/usecases"
+	"github.com/cs471-buffetpos/buffet-pos-backend/internal/adapters/gorm"
+	"github.com/cs471-buffetpos/buffet-pos-backend/utils"
 	"github.com/gofiber/fiber/v2"
 	"github.com/golang-jwt/jwt"
 )
 
-func AuthMiddleware(cfg *configs.Config) func(c *fiber.Ctx) error {
+// AuthMiddleware validates the bearer JWT and, beyond signature and
+// expiry, confirms its jti still maps to a non-revoked ticket - a
+// ticketCache hit avoids a database round trip on every request, only
+// falling through to ticketRepo on a cache miss. It also accepts
+// `Authorization: ApiKey <key>` for device credentials, resolving
+// them into a synthetic claims object carrying the key's scopes
+// instead of a user_id/role pair.
+func AuthMiddleware(cfg *configs.Config, ticketRepo gorm.TicketRepository, ticketCache *utils.TicketCache, apiKeyService usecases.APIKeyUseCase) func(c *fiber.Ctx) error {
 	return func(c *fiber.Ctx) error {
-		// Extract token from Authorization header
 		authHeader := c.Get("Authorization")
+
+		if strings.HasPrefix(authHeader, "ApiKey ") {
+			rawKey := strings.TrimPrefix(authHeader, "ApiKey ")
+			apiKey, err := apiKeyService.ResolveAPIKey(c.Context(), rawKey)
+			if err != nil {
+				return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+					"message": "Unauthorized",
+				})
+			}
+
+			c.Locals("user", jwt.MapClaims{
+				"api_key_id": apiKey.ID.String(),
+				"scopes":     apiKey.ScopeList(),
+			})
+			return c.Next()
+		}
+
+		// Extract token from Authorization header
 		if !strings.HasPrefix(authHeader, "Bearer ") {
 			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
 				"message": "Unauthorized",
@@ -29,6 +56,41 @@ func AuthMiddleware(cfg *configs.Config) func(c *fiber.Ctx) error {
 			})
 		}
 
+		claims, ok := token.Claims.(jwt.MapClaims)
+		if !ok {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"message": "Unauthorized",
+			})
+		}
+
+		jti, _ := claims["jti"].(string)
+		if jti == "" {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"message": "Unauthorized",
+			})
+		}
+
+		valid, cached := ticketCache.Get(jti)
+		if !cached {
+			ticket, err := ticketRepo.FindByJTI(c.Context(), jti)
+			if err != nil {
+				// A transient lookup failure isn't a verdict on the
+				// ticket - don't cache it as revoked and don't let a
+				// DB blip log the user out.
+				return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"message": "Internal Server Error",
+				})
+			}
+			valid = ticket != nil && ticket.RevokedAt == nil
+			ticketCache.Set(jti, valid)
+		}
+
+		if !valid {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"message": "Unauthorized",
+			})
+		}
+
 		// Store token claims in context
 		c.Locals("user", token.Claims)
 		return c.Next()
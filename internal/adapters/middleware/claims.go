@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+)
+
+// UserIDFromContext reads the user_id claim AuthMiddleware stored in
+// c.Locals("user") for the currently authenticated staff member.
+func UserIDFromContext(c *fiber.Ctx) (uuid.UUID, error) {
+	claims, ok := c.Locals("user").(jwt.MapClaims)
+	if !ok {
+		return uuid.Nil, errors.New("missing claims")
+	}
+
+	raw, ok := claims["user_id"].(string)
+	if !ok {
+		return uuid.Nil, errors.New("missing user_id claim")
+	}
+
+	return uuid.Parse(raw)
+}
+
+// JTIFromContext reads the jti claim identifying the current ticket.
+func JTIFromContext(c *fiber.Ctx) (string, error) {
+	claims, ok := c.Locals("user").(jwt.MapClaims)
+	if !ok {
+		return "", errors.New("missing claims")
+	}
+
+	jti, ok := claims["jti"].(string)
+	if !ok {
+		return "", errors.New("missing jti claim")
+	}
+	return jti, nil
+}
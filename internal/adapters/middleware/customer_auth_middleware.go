@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/usecases"
+	"github.com/gofiber/fiber/v2"
+)
+
+// CustomerAuthMiddleware resolves the AccessCode header against active
+// table assignments and stores the matching table in c.Locals("table")
+// so customer-facing handlers can identify which table is ordering.
+func CustomerAuthMiddleware(tableService usecases.TableUseCase) func(c *fiber.Ctx) error {
+	return func(c *fiber.Ctx) error {
+		accessCode := c.Get("AccessCode")
+		if accessCode == "" {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"message": "Unauthorized",
+			})
+		}
+
+		table, err := tableService.FindTableByAccessCode(c.Context(), accessCode)
+		if err != nil {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"message": "Unauthorized",
+			})
+		}
+
+		c.Locals("table", table)
+		return c.Next()
+	}
+}
@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt"
+)
+
+// ScopeMiddleware restricts a route to requests whose claims grant at
+// least one of the given scopes. Staff JWTs carry no scopes claim and
+// pass through unrestricted - only API-key-authenticated device
+// requests carry a scope list to check.
+func ScopeMiddleware(scopes ...string) func(c *fiber.Ctx) error {
+	return func(c *fiber.Ctx) error {
+		claims, ok := c.Locals("user").(jwt.MapClaims)
+		if !ok {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"message": "Unauthorized",
+			})
+		}
+
+		granted, isAPIKey := claims["scopes"].([]string)
+		if !isAPIKey {
+			return c.Next()
+		}
+
+		for _, required := range scopes {
+			for _, have := range granted {
+				if have == required {
+					return c.Next()
+				}
+			}
+		}
+
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"message": "Forbidden",
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package rest
+
+import (
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/exceptions"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/requests"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/usecases"
+	"github.com/cs471-buffetpos/buffet-pos-backend/internal/adapters/middleware"
+	"github.com/cs471-buffetpos/buffet-pos-backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type APIKeyHandler interface {
+	IssueAPIKey(c *fiber.Ctx) error
+	RevokeAPIKey(c *fiber.Ctx) error
+}
+
+type apiKeyHandler struct {
+	service usecases.APIKeyUseCase
+}
+
+func NewAPIKeyHandler(service usecases.APIKeyUseCase) APIKeyHandler {
+	return &apiKeyHandler{service: service}
+}
+
+// Issue API Key
+// @Summary Issue API Key
+// @Description Mint a scope-restricted API key for a kiosk or kitchen display.
+// @Tags Manage
+// @Accept json
+// @Produce json
+// @Param request body requests.CreateAPIKeyRequest true "Create API Key Request"
+// @Success 200 {object} responses.APIKeyCreated
+// @Router /manage/api-keys [post]
+// @Security ApiKeyAuth
+// @param Authorization header string true "Authorization"
+func (a *apiKeyHandler) IssueAPIKey(c *fiber.Ctx) error {
+	createdBy, err := middleware.UserIDFromContext(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"message": "Unauthorized",
+		})
+	}
+
+	var req *requests.CreateAPIKeyRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	if err := utils.ValidateStruct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(err)
+	}
+
+	apiKey, err := a.service.IssueAPIKey(c.Context(), createdBy, req)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(apiKey)
+}
+
+// Revoke API Key
+// @Summary Revoke API Key
+// @Description Revoke an API key by ID.
+// @Tags Manage
+// @Accept json
+// @Produce json
+// @Param id path string true "API Key ID"
+// @Success 200 {object} responses.SuccessResponse
+// @Router /manage/api-keys/{id} [delete]
+// @Security ApiKeyAuth
+// @param Authorization header string true "Authorization"
+func (a *apiKeyHandler) RevokeAPIKey(c *fiber.Ctx) error {
+	id, err := utils.ValidateUUID(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid UUID",
+		})
+	}
+
+	if err := a.service.RevokeAPIKey(c.Context(), *id); err != nil {
+		switch err {
+		case exceptions.ErrAPIKeyNotFound:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "API key not found",
+			})
+		default:
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error": err.Error(),
+			})
+		}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "API key revoked successfully",
+	})
+}
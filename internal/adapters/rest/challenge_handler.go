@@ -0,0 +1,143 @@
+package rest
+
+import (
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/exceptions"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/requests"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/usecases"
+	"github.com/cs471-buffetpos/buffet-pos-backend/internal/adapters/middleware"
+	"github.com/cs471-buffetpos/buffet-pos-backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type ChallengeHandler interface {
+	CreateChallenge(c *fiber.Ctx) error
+	VerifyChallenge(c *fiber.Ctx) error
+	BeginTOTPEnrollment(c *fiber.Ctx) error
+}
+
+type challengeHandler struct {
+	service usecases.ChallengeUseCase
+}
+
+func NewChallengeHandler(service usecases.ChallengeUseCase) ChallengeHandler {
+	return &challengeHandler{service: service}
+}
+
+// Create Challenge
+// @Summary Create Challenge
+// @Description Start a login challenge for a staff identifier.
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body requests.CreateChallengeRequest true "Create Challenge Request"
+// @Success 200 {object} responses.ChallengeResponse
+// @Router /auth/challenges [post]
+func (h *challengeHandler) CreateChallenge(c *fiber.Ctx) error {
+	var req *requests.CreateChallengeRequest
+	if err := c.BodyParser(&req); err != nil {
+		c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	if err := utils.ValidateStruct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(err)
+	}
+
+	challenge, err := h.service.CreateChallenge(c.Context(), req, c.IP(), c.Get("User-Agent"))
+	if err != nil {
+		switch err {
+		case exceptions.ErrUserNotFound:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "User not found",
+			})
+		default:
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error": err.Error(),
+			})
+		}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(challenge)
+}
+
+// Verify Challenge
+// @Summary Verify Challenge
+// @Description Satisfy one factor of a login challenge.
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param id path string true "Challenge ID"
+// @Param request body requests.VerifyChallengeRequest true "Verify Challenge Request"
+// @Success 200 {object} responses.VerifyChallengeResponse
+// @Router /auth/challenges/{id}/verify [post]
+func (h *challengeHandler) VerifyChallenge(c *fiber.Ctx) error {
+	id, err := utils.ValidateUUID(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid UUID",
+		})
+	}
+
+	var req *requests.VerifyChallengeRequest
+	if err := c.BodyParser(&req); err != nil {
+		c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	if err := utils.ValidateStruct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(err)
+	}
+
+	result, err := h.service.VerifyFactor(c.Context(), *id, req, c.IP(), c.Get("User-Agent"))
+	if err != nil {
+		switch err {
+		case exceptions.ErrChallengeNotFound, exceptions.ErrChallengeExpired, exceptions.ErrChallengeAlreadyCompleted, exceptions.ErrFactorNotFound, exceptions.ErrInvalidFactorSecret:
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": err.Error(),
+			})
+		default:
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error": err.Error(),
+			})
+		}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+// Begin TOTP Enrollment
+// @Summary Begin TOTP Enrollment
+// @Description Provision a TOTP factor for the authenticated user.
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Success 200 {object} responses.TOTPEnrollResponse
+// @Router /auth/factors/totp [post]
+// @Security ApiKeyAuth
+// @param Authorization header string true "Authorization"
+func (h *challengeHandler) BeginTOTPEnrollment(c *fiber.Ctx) error {
+	userID, err := middleware.UserIDFromContext(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"message": "Unauthorized",
+		})
+	}
+
+	enrollment, err := h.service.BeginTOTPEnrollment(c.Context(), userID)
+	if err != nil {
+		switch err {
+		case exceptions.ErrFactorAlreadyEnrolled:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "TOTP already enrolled",
+			})
+		default:
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error": err.Error(),
+			})
+		}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(enrollment)
+}
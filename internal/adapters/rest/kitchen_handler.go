@@ -0,0 +1,22 @@
+package rest
+
+import (
+	"github.com/cs471-buffetpos/buffet-pos-backend/internal/infrastructure/ws"
+	"github.com/gofiber/contrib/websocket"
+)
+
+// KitchenSocketHandler registers staff kitchen-view clients with the
+// order hub so they receive order.created/order.status_changed events
+// without polling, and unregisters them on disconnect.
+func KitchenSocketHandler(hub *ws.Hub) func(*websocket.Conn) {
+	return func(conn *websocket.Conn) {
+		hub.Register(conn)
+		defer hub.Unregister(conn)
+
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				break
+			}
+		}
+	}
+}
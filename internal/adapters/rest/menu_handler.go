@@ -0,0 +1,132 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/exceptions"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/requests"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/usecases"
+	"github.com/cs471-buffetpos/buffet-pos-backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type MenuHandler interface {
+	Create(c *fiber.Ctx) error
+	FindAll(c *fiber.Ctx) error
+	FindByID(c *fiber.Ctx) error
+}
+
+type menuHandler struct {
+	service usecases.MenuUseCase
+}
+
+func NewMenuHandler(service usecases.MenuUseCase) MenuHandler {
+	return &menuHandler{
+		service: service,
+	}
+}
+
+// Create Menu
+// @Summary Create Menu
+// @Description Add a new menu item.
+// @Tags Manage
+// @Accept json
+// @Produce json
+// @Param request body requests.CreateMenuRequest true "Create Menu request"
+// @Success 200 {object} responses.SuccessResponse
+// @Router /manage/menus [post]
+// @Security ApiKeyAuth
+// @param Authorization header string true "Authorization"
+func (h *menuHandler) Create(c *fiber.Ctx) error {
+	var req *requests.CreateMenuRequest
+	if err := c.BodyParser(&req); err != nil {
+		c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	if err := utils.ValidateStruct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(err)
+	}
+
+	if err := h.service.Create(c.Context(), req); err != nil {
+		switch err {
+		case exceptions.ErrCategoryNotFound:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Category not found",
+			})
+		default:
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error": err.Error(),
+			})
+		}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Menu item added successfully",
+	})
+}
+
+// Find All Menus
+// @Summary Find All Menus
+// @Description Find all menu items.
+// @Tags Manage
+// @Accept json
+// @Produce json
+// @Success 200 {array} responses.MenuDetail
+// @Router /manage/menus [get]
+// @Security ApiKeyAuth
+// @param Authorization header string true "Authorization"
+func (h *menuHandler) FindAll(c *fiber.Ctx) error {
+	cache := h.service.Cache()
+	c.Set(fiber.HeaderETag, cache.ETag())
+	c.Set(fiber.HeaderLastModified, cache.LastModified().UTC().Format(http.TimeFormat))
+
+	if cache.NotModified(c.Get(fiber.HeaderIfNoneMatch), c.Get(fiber.HeaderIfModifiedSince)) {
+		return c.SendStatus(fiber.StatusNotModified)
+	}
+
+	menus, err := h.service.FindAll(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(menus)
+}
+
+// Find Menu By ID
+// @Summary Find Menu By ID
+// @Description Find menu item by ID.
+// @Tags Manage
+// @Accept json
+// @Produce json
+// @Param id path string true "Menu ID"
+// @Success 200 {object} responses.MenuDetail
+// @Router /manage/menus/{id} [get]
+// @Security ApiKeyAuth
+// @param Authorization header string true "Authorization"
+func (h *menuHandler) FindByID(c *fiber.Ctx) error {
+	id, err := utils.ValidateUUID(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid UUID",
+		})
+	}
+
+	menu, err := h.service.FindByID(c.Context(), *id)
+	if err != nil {
+		switch err {
+		case exceptions.ErrMenuNotFound:
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": "Menu item not found",
+			})
+		default:
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error": err.Error(),
+			})
+		}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(menu)
+}
@@ -0,0 +1,145 @@
+package rest
+
+import (
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/exceptions"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/requests"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/responses"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/usecases"
+	"github.com/cs471-buffetpos/buffet-pos-backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type OrderHandler interface {
+	PlaceOrder(c *fiber.Ctx) error
+	FindAllOrders(c *fiber.Ctx) error
+	UpdateOrderStatus(c *fiber.Ctx) error
+}
+
+type orderHandler struct {
+	service usecases.OrderUseCase
+}
+
+func NewOrderHandler(service usecases.OrderUseCase) OrderHandler {
+	return &orderHandler{service: service}
+}
+
+// Place Order
+// @Summary Place Order
+// @Description Place a buffet order for the seated table.
+// @Tags Customer
+// @Accept json
+// @Produce json
+// @Param request body requests.PlaceOrderRequest true "Place Order Request"
+// @Success 200 {object} responses.OrderDetail
+// @Router /customer/orders [post]
+// @param AccessCode header string true "Access Code"
+func (o *orderHandler) PlaceOrder(c *fiber.Ctx) error {
+	table, _ := c.Locals("table").(*responses.TableDetail)
+
+	var req *requests.PlaceOrderRequest
+	if err := c.BodyParser(&req); err != nil {
+		c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	if err := utils.ValidateStruct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(err)
+	}
+
+	order, err := o.service.PlaceOrder(c.Context(), table.ID, req)
+	if err != nil {
+		switch err {
+		case exceptions.ErrTooManyConcurrentDishes:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Too many concurrent dishes for this table",
+			})
+		case exceptions.ErrOrderCooldownActive:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Cooldown between rounds is still active",
+			})
+		case exceptions.ErrMenuItemNotFound:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Menu item not found",
+			})
+		default:
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error": err.Error(),
+			})
+		}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(order)
+}
+
+// Find All Orders
+// @Summary Find All Orders
+// @Description Find all orders for the kitchen view.
+// @Tags Manage
+// @Accept json
+// @Produce json
+// @Success 200 {array} responses.OrderDetail
+// @Router /manage/orders [get]
+// @Security ApiKeyAuth
+// @param Authorization header string true "Authorization"
+func (o *orderHandler) FindAllOrders(c *fiber.Ctx) error {
+	orders, err := o.service.FindAllOrders(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(orders)
+}
+
+// Update Order Status
+// @Summary Update Order Status
+// @Description Transition an order to its next status.
+// @Tags Manage
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param request body requests.UpdateOrderStatusRequest true "Update Order Status Request"
+// @Success 200 {object} responses.OrderDetail
+// @Router /manage/orders/{id} [put]
+// @Security ApiKeyAuth
+// @param Authorization header string true "Authorization"
+func (o *orderHandler) UpdateOrderStatus(c *fiber.Ctx) error {
+	id, err := utils.ValidateUUID(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid UUID",
+		})
+	}
+
+	var req *requests.UpdateOrderStatusRequest
+	if err := c.BodyParser(&req); err != nil {
+		c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	if err := utils.ValidateStruct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(err)
+	}
+
+	order, err := o.service.UpdateOrderStatus(c.Context(), *id, req)
+	if err != nil {
+		switch err {
+		case exceptions.ErrOrderNotFound:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Order not found",
+			})
+		case exceptions.ErrInvalidStatusTransition:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid order status transition",
+			})
+		default:
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error": err.Error(),
+			})
+		}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(order)
+}
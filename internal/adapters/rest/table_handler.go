@@ -1,6 +1,8 @@
 package rest
 
 import (
+	"net/http"
+
 	"github.com/cs471-buffetpos/buffet-pos-backend/domain/exceptions"
 	"github.com/cs471-buffetpos/buffet-pos-backend/domain/requests"
 	"github.com/cs471-buffetpos/buffet-pos-backend/domain/responses"
@@ -81,6 +83,14 @@ func (t *tableHandler) AddTable(c *fiber.Ctx) error {
 // @Security ApiKeyAuth
 // @param Authorization header string true "Authorization"
 func (t *tableHandler) FindAllTables(c *fiber.Ctx) error {
+	cache := t.service.Cache()
+	c.Set(fiber.HeaderETag, cache.ETag())
+	c.Set(fiber.HeaderLastModified, cache.LastModified().UTC().Format(http.TimeFormat))
+
+	if cache.NotModified(c.Get(fiber.HeaderIfNoneMatch), c.Get(fiber.HeaderIfModifiedSince)) {
+		return c.SendStatus(fiber.StatusNotModified)
+	}
+
 	tables, err := t.service.FindAllTables(c.Context())
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
@@ -0,0 +1,96 @@
+package rest
+
+import (
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/exceptions"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/usecases"
+	"github.com/cs471-buffetpos/buffet-pos-backend/internal/adapters/middleware"
+	"github.com/cs471-buffetpos/buffet-pos-backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type TicketHandler interface {
+	ListTickets(c *fiber.Ctx) error
+	RevokeTicket(c *fiber.Ctx) error
+}
+
+type ticketHandler struct {
+	service usecases.TicketUseCase
+}
+
+func NewTicketHandler(service usecases.TicketUseCase) TicketHandler {
+	return &ticketHandler{service: service}
+}
+
+// List Tickets
+// @Summary List Tickets
+// @Description List the authenticated user's active sessions.
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Success 200 {array} responses.TicketDetail
+// @Router /auth/me/tickets [get]
+// @Security ApiKeyAuth
+// @param Authorization header string true "Authorization"
+func (t *ticketHandler) ListTickets(c *fiber.Ctx) error {
+	userID, err := middleware.UserIDFromContext(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"message": "Unauthorized",
+		})
+	}
+
+	currentJTI, _ := middleware.JTIFromContext(c)
+
+	tickets, err := t.service.ListTickets(c.Context(), userID, currentJTI)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(tickets)
+}
+
+// Revoke Ticket
+// @Summary Revoke Ticket
+// @Description Revoke one of the authenticated user's sessions, including the current one.
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param id path string true "Ticket ID"
+// @Success 200 {object} responses.SuccessResponse
+// @Router /auth/me/tickets/{id} [delete]
+// @Security ApiKeyAuth
+// @param Authorization header string true "Authorization"
+func (t *ticketHandler) RevokeTicket(c *fiber.Ctx) error {
+	userID, err := middleware.UserIDFromContext(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"message": "Unauthorized",
+		})
+	}
+
+	ticketID, err := utils.ValidateUUID(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid UUID",
+		})
+	}
+
+	if err := t.service.RevokeTicket(c.Context(), userID, *ticketID); err != nil {
+		switch err {
+		case exceptions.ErrTicketNotFound:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Ticket not found",
+			})
+		default:
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error": err.Error(),
+			})
+		}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Ticket revoked successfully",
+	})
+}
@@ -0,0 +1,60 @@
+package rest
+
+import (
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/exceptions"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/requests"
+	"github.com/cs471-buffetpos/buffet-pos-backend/domain/usecases"
+	"github.com/cs471-buffetpos/buffet-pos-backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type UserHandler interface {
+	Register(c *fiber.Ctx) error
+}
+
+type userHandler struct {
+	service usecases.UserUseCase
+}
+
+func NewUserHandler(service usecases.UserUseCase) UserHandler {
+	return &userHandler{service: service}
+}
+
+// Register
+// @Summary Register
+// @Description Register a new staff account.
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body requests.RegisterRequest true "Register Request"
+// @Success 200 {object} responses.SuccessResponse
+// @Router /auth/register [post]
+func (u *userHandler) Register(c *fiber.Ctx) error {
+	var req *requests.RegisterRequest
+	if err := c.BodyParser(&req); err != nil {
+		c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	if err := utils.ValidateStruct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(err)
+	}
+
+	if err := u.service.Register(c.Context(), req); err != nil {
+		switch err {
+		case exceptions.ErrDuplicatedEmail:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Email already registered",
+			})
+		default:
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error": err.Error(),
+			})
+		}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Registered successfully",
+	})
+}
@@ -0,0 +1,53 @@
+package ws
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/gofiber/contrib/websocket"
+)
+
+// Hub fans order events out to every staff client currently connected
+// to the kitchen view, so it updates without polling.
+type Hub struct {
+	mu      sync.Mutex
+	clients map[*websocket.Conn]struct{}
+}
+
+func NewHub() *Hub {
+	return &Hub{clients: make(map[*websocket.Conn]struct{})}
+}
+
+func (h *Hub) Register(conn *websocket.Conn) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.clients[conn] = struct{}{}
+}
+
+func (h *Hub) Unregister(conn *websocket.Conn) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.clients, conn)
+}
+
+// Event is the envelope broadcast to staff clients on order changes.
+type Event struct {
+	Type  string      `json:"type"`
+	Order interface{} `json:"order"`
+}
+
+func (h *Hub) Broadcast(event Event) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for conn := range h.clients {
+		if err := conn.WriteMessage(websocket.TextMessage, payload); err != nil {
+			conn.Close()
+			delete(h.clients, conn)
+		}
+	}
+}
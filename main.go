@@ -8,7 +8,9 @@ import (
 	"github.com/cs471-buffetpos/buffet-pos-backend/internal/adapters/gorm"
 	"github.com/cs471-buffetpos/buffet-pos-backend/internal/adapters/middleware"
 	"github.com/cs471-buffetpos/buffet-pos-backend/internal/adapters/rest"
-	"github.com/cs471-buffetpos/buffet-pos-backend/internal/infrastructure/cloudinary"
+	"github.com/cs471-buffetpos/buffet-pos-backend/internal/infrastructure/ws"
+	"github.com/cs471-buffetpos/buffet-pos-backend/utils"
+	"github.com/gofiber/contrib/websocket"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/gofiber/swagger"
@@ -25,12 +27,26 @@ func main() {
 
 	db := bootstrap.NewDB(cfg)
 
-	cld := cloudinary.NewCloudinaryStorageService(cfg)
-
 	userRepo := gorm.NewUserGormRepository(db)
 	userService := usecases.NewUserService(userRepo, cfg)
 	userHandler := rest.NewUserHandler(userService)
 
+	factorRepo := gorm.NewFactorGormRepository(db)
+	challengeRepo := gorm.NewChallengeGormRepository(db)
+	ticketRepo := gorm.NewTicketGormRepository(db)
+	ticketCache := utils.NewTicketCache(1024)
+	challengeService := usecases.NewChallengeService(userRepo, factorRepo, challengeRepo, ticketRepo, cfg)
+	challengeHandler := rest.NewChallengeHandler(challengeService)
+
+	ticketService := usecases.NewTicketService(ticketRepo, ticketCache)
+	ticketHandler := rest.NewTicketHandler(ticketService)
+
+	apiKeyRepo := gorm.NewAPIKeyGormRepository(db)
+	apiKeyService := usecases.NewAPIKeyService(apiKeyRepo)
+	apiKeyHandler := rest.NewAPIKeyHandler(apiKeyService)
+
+	authMiddleware := middleware.AuthMiddleware(cfg, ticketRepo, ticketCache, apiKeyService)
+
 	tableRepo := gorm.NewTableGormRepository(db)
 	tableService := usecases.NewTableService(tableRepo, cfg)
 	tableHandler := rest.NewTableHandler(tableService)
@@ -40,9 +56,14 @@ func main() {
 	categoryHandler := rest.NewCategoryHandler(categoryService)
 
 	menuRepo := gorm.NewMenuGormRepository(db)
-	menuService := usecases.NewMenuService(menuRepo, cfg, cld)
+	menuService := usecases.NewMenuService(menuRepo, categoryRepo, cfg)
 	menuHandler := rest.NewMenuHandler(menuService)
 
+	orderHub := ws.NewHub()
+	orderRepo := gorm.NewOrderGormRepository(db)
+	orderService := usecases.NewOrderService(orderRepo, menuRepo, cfg, orderHub)
+	orderHandler := rest.NewOrderHandler(orderService)
+
 	app.Use(cors.New())
 
 	app.Get("/swagger/*", swagger.HandlerDefault)
@@ -53,22 +74,47 @@ func main() {
 
 	auth := app.Group("/auth")
 	auth.Post("/register", userHandler.Register)
-	auth.Post("/login", userHandler.Login)
-
-	manage := app.Group("/manage", middleware.AuthMiddleware(cfg), middleware.RoleMiddleware(models.Employee, models.Manager))
-	manage.Get("/tables", tableHandler.FindAllTables)
-	manage.Get("/tables/:id", tableHandler.FindTableByID)
+	auth.Post("/challenges", challengeHandler.CreateChallenge)
+	auth.Post("/challenges/:id/verify", challengeHandler.VerifyChallenge)
+	auth.Post("/factors/totp", authMiddleware, challengeHandler.BeginTOTPEnrollment)
+	auth.Get("/me/tickets", authMiddleware, ticketHandler.ListTickets)
+	auth.Delete("/me/tickets/:id", authMiddleware, ticketHandler.RevokeTicket)
+
+	// manageRead holds endpoints a scoped API key is allowed to reach
+	// alongside staff - RoleMiddleware never runs here, so ScopeMiddleware
+	// alone decides whether a device request is let through.
+	manageRead := app.Group("/manage", authMiddleware)
+	manageRead.Get("/tables", middleware.ScopeMiddleware("tables:read"), tableHandler.FindAllTables)
+	manageRead.Get("/tables/:id", middleware.ScopeMiddleware("tables:read"), tableHandler.FindTableByID)
+	manageRead.Get("/categories", middleware.ScopeMiddleware("menus:read"), categoryHandler.FindAllCategories)
+	manageRead.Get("/categories/:id", middleware.ScopeMiddleware("menus:read"), categoryHandler.FindCategoryByID)
+	manageRead.Get("/menus", middleware.ScopeMiddleware("menus:read"), menuHandler.FindAll)
+	manageRead.Get("/menus/:id", middleware.ScopeMiddleware("menus:read"), menuHandler.FindByID)
+	manageRead.Get("/orders", middleware.ScopeMiddleware("orders:read"), orderHandler.FindAllOrders)
+
+	// manage holds every mutating endpoint - API keys carry no role
+	// claim and RoleMiddleware rejects them outright here, so only
+	// staff JWTs ever reach these handlers.
+	manage := app.Group("/manage", authMiddleware, middleware.RoleMiddleware(models.Employee, models.Manager))
 	manage.Post("/tables", tableHandler.AddTable)
 	manage.Put("/tables", tableHandler.Edit)
 	manage.Delete("/tables/:id", tableHandler.Delete)
+	manage.Post("/tables/assign", tableHandler.AssignTable)
 
-	manage.Get("/categories", categoryHandler.FindAllCategories)
-	manage.Get("/categories/:id", categoryHandler.FindCategoryByID)
 	manage.Post("/categories", categoryHandler.AddCategory)
 
-	manage.Get("/menus", menuHandler.FindAll)
-	manage.Get("/menus/:id", menuHandler.FindByID)
 	manage.Post("/menus", menuHandler.Create)
 
+	manage.Put("/orders/:id", orderHandler.UpdateOrderStatus)
+
+	manage.Post("/api-keys", middleware.RoleMiddleware(models.Manager), apiKeyHandler.IssueAPIKey)
+	manage.Delete("/api-keys/:id", middleware.RoleMiddleware(models.Manager), apiKeyHandler.RevokeAPIKey)
+
+	app.Get("/manage/orders/ws", websocket.New(rest.KitchenSocketHandler(orderHub)))
+
+	customer := app.Group("/customer", middleware.CustomerAuthMiddleware(tableService))
+	customer.Get("/tables", tableHandler.FindCustomerTable)
+	customer.Post("/orders", orderHandler.PlaceOrder)
+
 	app.Listen(":3000")
 }
@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ResourceCache tracks the last time a resource collection changed,
+// so list handlers can answer conditional GETs with 304 instead of
+// re-serializing the full dataset for every polling tablet.
+type ResourceCache struct {
+	mu       sync.RWMutex
+	lastEdit time.Time
+}
+
+func NewResourceCache() *ResourceCache {
+	return &ResourceCache{lastEdit: time.Now().Truncate(time.Second)}
+}
+
+// Touch bumps the resource's last-edit time; call it from the usecase
+// on Add/Edit/Delete. Truncated to a second so it compares cleanly
+// against the second-precision If-Modified-Since the client echoes
+// back from our Last-Modified header.
+func (c *ResourceCache) Touch() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lastEdit = time.Now().Truncate(time.Second)
+}
+
+func (c *ResourceCache) LastModified() time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.lastEdit
+}
+
+// ETag derives a weak ETag from the last-edit time.
+func (c *ResourceCache) ETag() string {
+	sum := sha1.Sum([]byte(c.LastModified().UTC().Format(time.RFC3339Nano)))
+	return fmt.Sprintf(`W/"%s"`, hex.EncodeToString(sum[:])[:16])
+}
+
+// NotModified checks the request's conditional headers against the
+// cache and reports whether a 304 can be returned instead of the body.
+// If-None-Match takes precedence over If-Modified-Since, per RFC 7232.
+func (c *ResourceCache) NotModified(ifNoneMatch, ifModifiedSince string) bool {
+	if ifNoneMatch != "" {
+		return ifNoneMatch == c.ETag()
+	}
+	if ifModifiedSince == "" {
+		return false
+	}
+
+	since, err := http.ParseTime(ifModifiedSince)
+	if err != nil {
+		return false
+	}
+	return !c.LastModified().After(since)
+}
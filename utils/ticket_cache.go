@@ -0,0 +1,76 @@
+package utils
+
+import (
+	"container/list"
+	"sync"
+)
+
+// TicketCache is a small in-memory LRU cache of jti -> valid, so
+// AuthMiddleware doesn't hit the database to check ticket revocation
+// on every request.
+type TicketCache struct {
+	mu       sync.Mutex
+	capacity int
+	items    map[string]*list.Element
+	order    *list.List
+}
+
+type ticketCacheEntry struct {
+	jti   string
+	valid bool
+}
+
+func NewTicketCache(capacity int) *TicketCache {
+	return &TicketCache{
+		capacity: capacity,
+		items:    make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+func (c *TicketCache) Get(jti string) (valid bool, found bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[jti]
+	if !ok {
+		return false, false
+	}
+	c.order.MoveToFront(el)
+	return el.Value.(*ticketCacheEntry).valid, true
+}
+
+func (c *TicketCache) Set(jti string, valid bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[jti]; ok {
+		el.Value.(*ticketCacheEntry).valid = valid
+		c.order.MoveToFront(el)
+		return
+	}
+
+	el := c.order.PushFront(&ticketCacheEntry{jti: jti, valid: valid})
+	c.items[jti] = el
+
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		if oldest != nil {
+			c.order.Remove(oldest)
+			delete(c.items, oldest.Value.(*ticketCacheEntry).jti)
+		}
+	}
+}
+
+// Invalidate drops a cached entry, used when a ticket is revoked so
+// the next request re-checks the database instead of serving a stale
+// "valid" hit.
+func (c *TicketCache) Invalidate(jti string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[jti]; ok {
+		c.order.Remove(el)
+		delete(c.items, jti)
+	}
+}
@@ -0,0 +1,13 @@
+package utils
+
+import "github.com/google/uuid"
+
+// ValidateUUID parses a path/query string into a UUID, returning an
+// error the caller can turn into a 400 response.
+func ValidateUUID(raw string) (*uuid.UUID, error) {
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
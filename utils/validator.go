@@ -0,0 +1,14 @@
+package utils
+
+import "github.com/go-playground/validator/v10"
+
+var validate = validator.New()
+
+// ValidateStruct runs struct tag validation and returns the raw
+// validator error so handlers can surface field-level messages.
+func ValidateStruct(s interface{}) error {
+	if err := validate.Struct(s); err != nil {
+		return err
+	}
+	return nil
+}